@@ -17,6 +17,7 @@ type Buffer interface {
 	Dequeue() bool
 	IsFull() bool
 	IsEmpty() bool
+	Len() int
 }
 
 type buffer struct {
@@ -99,3 +100,11 @@ func (buf *buffer) IsFull() bool {
 func (buf *buffer) IsEmpty() bool {
 	return buf.empty
 }
+
+// Len returns the number of Elements currently stored in the Buffer.
+func (buf *buffer) Len() int {
+	if buf.IsFull() {
+		return len(buf.elems)
+	}
+	return (buf.free - buf.top + len(buf.elems)) % len(buf.elems)
+}
@@ -50,6 +50,11 @@ var _ = Describe("Buffer", func() {
 					buffer := buildFullBuffer(entry.cap)
 					Expect(buffer.IsEmpty()).To(BeFalse())
 				})
+
+				It("should have a length equal to its capacity", func() {
+					buffer := buildFullBuffer(entry.cap)
+					Expect(buffer.Len()).To(Equal(entry.cap))
+				})
 			})
 
 			Context("when enqueueing an element", func() {
@@ -92,6 +97,11 @@ var _ = Describe("Buffer", func() {
 					buffer := buildEmptyBuffer(entry.cap)
 					Expect(buffer.IsEmpty()).To(BeTrue())
 				})
+
+				It("should have a length of zero", func() {
+					buffer := buildEmptyBuffer(entry.cap)
+					Expect(buffer.Len()).To(Equal(0))
+				})
 			})
 
 			Context("when enqueueing elements", func() {
@@ -154,6 +164,11 @@ var _ = Describe("Buffer", func() {
 					buffer := buildHalfFullBuffer(entry.cap)
 					Expect(buffer.IsEmpty()).To(BeFalse())
 				})
+
+				It("should have a length equal to half its capacity", func() {
+					buffer := buildHalfFullBuffer(entry.cap)
+					Expect(buffer.Len()).To(Equal(entry.cap / 2))
+				})
 			})
 
 			Context("when enqueueing elements", func() {
@@ -201,6 +216,7 @@ var _ = Describe("Buffer", func() {
 					}
 					Expect(buffer.IsFull()).To(BeFalse())
 					Expect(buffer.IsEmpty()).To(BeFalse())
+					Expect(buffer.Len()).To(Equal(entry.cap / 2))
 				})
 			})
 
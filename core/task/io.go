@@ -3,6 +3,9 @@ package task
 import (
 	"log"
 	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/republicprotocol/tau/core/buffer"
 )
@@ -14,18 +17,48 @@ type IO interface {
 	WriteIn(message Message) bool
 	WriteOut(message Message) bool
 
+	// WriteInTimeout writes a Message to the input buffer. If the input buffer
+	// is full, it blocks until space is available, or until the timeout has
+	// elapsed, in which case the Message is dropped. Returns true if the
+	// Message was written, otherwise it returns false.
+	WriteInTimeout(message Message, timeout time.Duration) bool
+
 	InputBuffer() buffer.Buffer
 	InputWriter() chan<- Message
 
 	OutputBuffer() buffer.Buffer
 	OutputReader() <-chan Message
+
+	// Stats returns a snapshot of the IOStats. It is safe to call from any
+	// goroutine.
+	Stats() IOStats
+}
+
+// IOStats is a snapshot of the backpressure on an IO. It is used to diagnose
+// why Messages are being dropped between Tasks.
+type IOStats struct {
+	// InputLen and OutputLen are the number of Messages waiting in the input
+	// and output buffers.
+	InputLen  int
+	OutputLen int
+
+	// Dropped is the number of Messages that have been dropped because a
+	// buffer was full.
+	Dropped uint64
+
+	// Blocked is the number of writes that found a full buffer and had to wait
+	// for space, regardless of whether they eventually succeeded.
+	Blocked uint64
 }
 
 type inputOutput struct {
-	ibuf buffer.Buffer
+	dropped uint64
+	blocked uint64
+
+	ibuf *syncBuffer
 	r    chan Message
 
-	obuf buffer.Buffer
+	obuf *syncBuffer
 	w    chan Message
 }
 
@@ -36,10 +69,10 @@ func NewIO(cap int) IO {
 	w := make(chan Message, cap)
 
 	return &inputOutput{
-		ibuf: buffer.New(cap),
+		ibuf: newSyncBuffer(cap),
 		r:    r,
 
-		obuf: buffer.New(cap),
+		obuf: newSyncBuffer(cap),
 		w:    w,
 	}
 }
@@ -136,6 +169,7 @@ func (io *inputOutput) Flush(done <-chan struct{}, reducer Reducer, children Chi
 func (io *inputOutput) WriteIn(message Message) bool {
 	ok := io.ibuf.Enqueue(message)
 	if !ok {
+		atomic.AddUint64(&io.dropped, 1)
 		// TODO: Support for configurable logging.
 		log.Printf("[error] (io, write) buffer overflow")
 	}
@@ -145,12 +179,38 @@ func (io *inputOutput) WriteIn(message Message) bool {
 func (io *inputOutput) WriteOut(message Message) bool {
 	ok := io.obuf.Enqueue(message)
 	if !ok {
+		atomic.AddUint64(&io.dropped, 1)
 		// TODO: Support for configurable logging.
 		log.Printf("[error] (io, write) buffer overflow")
 	}
 	return ok
 }
 
+func (io *inputOutput) WriteInTimeout(message Message, timeout time.Duration) bool {
+	if io.ibuf.Enqueue(message) {
+		return true
+	}
+	atomic.AddUint64(&io.blocked, 1)
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-timer.C:
+			atomic.AddUint64(&io.dropped, 1)
+			// TODO: Support for configurable logging.
+			log.Printf("[error] (io, write) buffer overflow after %v", timeout)
+			return false
+
+		case <-io.ibuf.dequeued:
+			if io.ibuf.Enqueue(message) {
+				return true
+			}
+		}
+	}
+}
+
 func (io *inputOutput) InputBuffer() buffer.Buffer {
 	return io.ibuf
 }
@@ -167,22 +227,110 @@ func (io *inputOutput) OutputReader() <-chan Message {
 	return io.w
 }
 
+func (io *inputOutput) Stats() IOStats {
+	return IOStats{
+		InputLen:  io.ibuf.Len(),
+		OutputLen: io.obuf.Len(),
+		Dropped:   atomic.LoadUint64(&io.dropped),
+		Blocked:   atomic.LoadUint64(&io.blocked),
+	}
+}
+
 func (io *inputOutput) reduceMessage(reducer Reducer, message Message) Message {
 	if messages, ok := message.(MessageBatch); ok {
-		for i := 0; i < len(messages); i++ {
-			if messages[i] != nil {
-				messages[i] = io.reduceMessage(reducer, messages[i])
+		// The MessageBatch may have been sent to other Tasks, so it must not be
+		// modified in place
+		reduced := make(MessageBatch, 0, len(messages))
+		for _, msg := range messages {
+			if msg == nil {
+				continue
 			}
-			if messages[i] == nil {
-				messages[i] = messages[len(messages)-1]
-				messages = messages[:len(messages)-1]
-				i--
+			if msg = io.reduceMessage(reducer, msg); msg != nil {
+				reduced = append(reduced, msg)
 			}
 		}
-		if len(messages) == 0 {
+		if len(reduced) == 0 {
 			return nil
 		}
-		return messages
+		return reduced
 	}
 	return reducer.Reduce(message)
 }
+
+// A syncBuffer is a buffer.Buffer that is safe for concurrent use. The input
+// buffer of a Task is written by callers of Task.Send and drained by the parent
+// Task, so access to it must be synchronised. The dequeued channel is signalled
+// whenever space is made available, so that blocked writers can retry.
+//
+// Unlike a buffer.Buffer, peeking at an empty syncBuffer returns a Peeker that
+// will produce the next Element to be enqueued. This allows Flush to wake up
+// when a Message is written to the buffer by another goroutine.
+type syncBuffer struct {
+	mu       sync.Mutex
+	buf      buffer.Buffer
+	peek     chan buffer.Element
+	dequeued chan struct{}
+}
+
+func newSyncBuffer(cap int) *syncBuffer {
+	return &syncBuffer{
+		buf:      buffer.New(cap),
+		dequeued: make(chan struct{}, 1),
+	}
+}
+
+func (buf *syncBuffer) Peek() buffer.Peeker {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+	if buf.buf.IsEmpty() {
+		if buf.peek == nil {
+			buf.peek = make(chan buffer.Element, 1)
+		}
+		return buf.peek
+	}
+	return buf.buf.Peek()
+}
+
+func (buf *syncBuffer) Enqueue(elem buffer.Element) bool {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+	if !buf.buf.Enqueue(elem) {
+		return false
+	}
+	if buf.peek != nil {
+		buf.peek <- elem
+		buf.peek = nil
+	}
+	return true
+}
+
+func (buf *syncBuffer) Dequeue() bool {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+	if !buf.buf.Dequeue() {
+		return false
+	}
+	select {
+	case buf.dequeued <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+func (buf *syncBuffer) IsFull() bool {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+	return buf.buf.IsFull()
+}
+
+func (buf *syncBuffer) IsEmpty() bool {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+	return buf.buf.IsEmpty()
+}
+
+func (buf *syncBuffer) Len() int {
+	buf.mu.Lock()
+	defer buf.mu.Unlock()
+	return buf.buf.Len()
+}
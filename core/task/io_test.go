@@ -1 +1,72 @@
 package task_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/republicprotocol/tau/core/task"
+)
+
+var _ = Describe("IO", func() {
+
+	Context("when flooding an IO with a small capacity", func() {
+		It("should count the dropped messages", func() {
+			io := NewIO(4)
+			for i := 0; i < 16; i++ {
+				ok := io.WriteIn(RandomMessage{})
+				Expect(ok).To(Equal(i < 4))
+			}
+			for i := 0; i < 8; i++ {
+				ok := io.WriteOut(RandomMessage{})
+				Expect(ok).To(Equal(i < 4))
+			}
+
+			stats := io.Stats()
+			Expect(stats.InputLen).To(Equal(4))
+			Expect(stats.OutputLen).To(Equal(4))
+			Expect(stats.Dropped).To(Equal(uint64(16)))
+			Expect(stats.Blocked).To(Equal(uint64(0)))
+		})
+	})
+
+	Context("when writing with a timeout", func() {
+		It("should write immediately when the buffer is not full", func() {
+			io := NewIO(1)
+			Expect(io.WriteInTimeout(RandomMessage{}, time.Millisecond)).To(BeTrue())
+
+			stats := io.Stats()
+			Expect(stats.InputLen).To(Equal(1))
+			Expect(stats.Blocked).To(Equal(uint64(0)))
+		})
+
+		It("should drop the message when the buffer stays full", func() {
+			io := NewIO(1)
+			Expect(io.WriteIn(RandomMessage{})).To(BeTrue())
+			Expect(io.WriteInTimeout(RandomMessage{}, 10*time.Millisecond)).To(BeFalse())
+
+			stats := io.Stats()
+			Expect(stats.InputLen).To(Equal(1))
+			Expect(stats.Dropped).To(Equal(uint64(1)))
+			Expect(stats.Blocked).To(Equal(uint64(1)))
+		})
+
+		It("should write the message when the buffer is drained before the timeout", func() {
+			io := NewIO(1)
+			Expect(io.WriteIn(RandomMessage{})).To(BeTrue())
+
+			go func() {
+				defer GinkgoRecover()
+
+				time.Sleep(10 * time.Millisecond)
+				Expect(io.InputBuffer().Dequeue()).To(BeTrue())
+			}()
+			Expect(io.WriteInTimeout(RandomMessage{}, time.Second)).To(BeTrue())
+
+			stats := io.Stats()
+			Expect(stats.InputLen).To(Equal(1))
+			Expect(stats.Dropped).To(Equal(uint64(0)))
+			Expect(stats.Blocked).To(Equal(uint64(1)))
+		})
+	})
+})
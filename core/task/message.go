@@ -1,7 +1,7 @@
 package task
 
 import (
-	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"runtime/debug"
 	"time"
@@ -15,10 +15,30 @@ import (
 // Messages in the same series.
 type MessageID [40]byte
 
+// ParseMessageID returns the MessageID encoded by a hex string, as returned by
+// MessageID.String. It returns an error if the string is not valid hex, or if
+// it does not decode to exactly the length of a MessageID.
+func ParseMessageID(s string) (MessageID, error) {
+	id := MessageID{}
+	data, err := hex.DecodeString(s)
+	if err != nil {
+		return id, fmt.Errorf("cannot parse message id: %v", err)
+	}
+	if len(data) != len(id) {
+		return id, fmt.Errorf("cannot parse message id: expected %v bytes, got %v bytes", len(id), len(data))
+	}
+	copy(id[:], data)
+	return id, nil
+}
+
+// Equal returns true if the MessageIDs are equal, otherwise it returns false.
+func (id MessageID) Equal(other MessageID) bool {
+	return id == other
+}
+
+// String returns the hex encoding of the MessageID.
 func (id MessageID) String() string {
-	idBase64 := base64.StdEncoding.EncodeToString(id[:])
-	idRunes := []rune(idBase64)
-	return string(idRunes[24:])
+	return hex.EncodeToString(id[:])
 }
 
 // A Message is an interface that can be sent between Tasks.
@@ -43,17 +63,101 @@ func NewMessageBatch(messages []Message) Message {
 func (message MessageBatch) IsMessage() {
 }
 
+// An ErrorKind classifies an Error so that the receiver can decide whether or
+// not the computation that caused the Error can be retried.
+type ErrorKind uint8
+
+// ErrorKind values.
+const (
+	// ErrorKindFatal is used for Errors that will not be resolved by retrying,
+	// such as receiving an unexpected Message.
+	ErrorKindFatal ErrorKind = iota
+
+	// ErrorKindTransient is used for Errors caused by a temporary condition,
+	// such as a full buffer. Retrying may succeed.
+	ErrorKindTransient
+)
+
 // An Error is a Message wrapper type for sending errors between Tasks. It
 // automatically catches the stack trace to help with debugging the origin of
+// the error. It can optionally carry the MessageID of the Message that caused
 // the error.
 type Error struct {
 	error
+
+	cause error
+	kind  ErrorKind
+	id    MessageID
+	hasID bool
 }
 
 // NewError returns an Error. The stack trace is captured at the moment this
-// function is called.
+// function is called. If the error is itself an Error, its ErrorKind and
+// MessageID are preserved, otherwise the Error is fatal.
 func NewError(err error) Message {
-	return Error{fmt.Errorf("err = %v; stack = %v", err, string(debug.Stack()))}
+	return newError(err, errorKind(err))
+}
+
+// NewTransientError returns an Error with the ErrorKindTransient kind. The
+// stack trace is captured at the moment this function is called. If the error
+// is itself an Error, its MessageID is preserved.
+func NewTransientError(err error) Message {
+	return newError(err, ErrorKindTransient)
+}
+
+// NewErrorWithMessageID returns an Error that carries the MessageID of the
+// Message that caused it. The stack trace is captured at the moment this
+// function is called. If the error is itself an Error, its ErrorKind is
+// preserved, otherwise the Error is fatal.
+func NewErrorWithMessageID(err error, id MessageID) Message {
+	message := newError(err, errorKind(err))
+	message.id = id
+	message.hasID = true
+	return message
+}
+
+func newError(err error, kind ErrorKind) Error {
+	message := Error{
+		error: fmt.Errorf("err = %v; stack = %v", err, string(debug.Stack())),
+
+		cause: err,
+		kind:  kind,
+	}
+	if inner, ok := err.(Error); ok {
+		message.id = inner.id
+		message.hasID = inner.hasID
+	}
+	return message
+}
+
+func errorKind(err error) ErrorKind {
+	if inner, ok := err.(Error); ok {
+		return inner.kind
+	}
+	return ErrorKindFatal
+}
+
+// Kind returns the ErrorKind of the Error.
+func (message Error) Kind() ErrorKind {
+	return message.kind
+}
+
+// IsTransient returns true if the Error is transient, and the computation that
+// caused it can be retried, otherwise it returns false.
+func (message Error) IsTransient() bool {
+	return message.kind == ErrorKindTransient
+}
+
+// MessageID returns the MessageID of the Message that caused the Error. It
+// returns false if the Error does not carry a MessageID.
+func (message Error) MessageID() (MessageID, bool) {
+	return message.id, message.hasID
+}
+
+// Unwrap returns the error that was used to create the Error, so that the
+// Error can be inspected using errors.Is and errors.As.
+func (message Error) Unwrap() error {
+	return message.cause
 }
 
 // IsMessage implements the Message interface for Error.
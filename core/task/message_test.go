@@ -1 +1,146 @@
 package task_test
+
+import (
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/republicprotocol/tau/core/task"
+
+	"github.com/republicprotocol/tau/core/taskutils"
+)
+
+var _ = Describe("Messages", func() {
+
+	Context("when converting a message id to a string", func() {
+		It("should parse the string back into the same message id", func() {
+			for i := 0; i < 64; i++ {
+				msgid := taskutils.RandomMessageID()
+				id, err := ParseMessageID(msgid.String())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(id).To(Equal(msgid))
+				Expect(id.Equal(msgid)).To(BeTrue())
+			}
+		})
+
+		It("should encode the message id as hex", func() {
+			msgid := MessageID{}
+			msgid[0] = 0xAB
+			msgid[len(msgid)-1] = 0x01
+			Expect(msgid.String()).To(HavePrefix("ab00"))
+			Expect(msgid.String()).To(HaveSuffix("0001"))
+			Expect(msgid.String()).To(HaveLen(2 * len(msgid)))
+		})
+	})
+
+	Context("when parsing a malformed message id", func() {
+		It("should return an error for invalid hex", func() {
+			_, err := ParseMessageID("not hex")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should return an error for the wrong length", func() {
+			_, err := ParseMessageID("abcd")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when comparing message ids", func() {
+		It("should not be equal to a different message id", func() {
+			msgid := taskutils.RandomMessageID()
+			other := msgid
+			other[0]++
+			Expect(msgid.Equal(other)).To(BeFalse())
+		})
+	})
+
+	errCause := errors.New("cause")
+
+	Context("when creating an error", func() {
+		It("should not carry a message id", func() {
+			err := NewError(errCause).(Error)
+			_, ok := err.MessageID()
+			Expect(ok).To(BeFalse())
+		})
+
+		It("should unwrap to the cause", func() {
+			err := NewError(errCause).(Error)
+			Expect(errors.Is(err, errCause)).To(BeTrue())
+			Expect(err.Unwrap()).To(Equal(errCause))
+		})
+	})
+
+	Context("when creating an error with a message id", func() {
+		It("should carry the message id", func() {
+			msgid := taskutils.RandomMessageID()
+			err := NewErrorWithMessageID(errCause, msgid).(Error)
+			id, ok := err.MessageID()
+			Expect(ok).To(BeTrue())
+			Expect(id).To(Equal(msgid))
+		})
+
+		It("should unwrap to the cause", func() {
+			err := NewErrorWithMessageID(errCause, taskutils.RandomMessageID()).(Error)
+			Expect(errors.Is(err, errCause)).To(BeTrue())
+		})
+	})
+
+	Context("when wrapping an error that carries a message id", func() {
+		It("should preserve the message id", func() {
+			msgid := taskutils.RandomMessageID()
+			inner := NewErrorWithMessageID(errCause, msgid).(Error)
+			err := NewError(inner).(Error)
+			id, ok := err.MessageID()
+			Expect(ok).To(BeTrue())
+			Expect(id).To(Equal(msgid))
+		})
+
+		It("should unwrap through the whole chain", func() {
+			inner := NewErrorWithMessageID(errCause, taskutils.RandomMessageID()).(Error)
+			err := NewError(inner).(Error)
+
+			var target Error
+			Expect(errors.As(err.Unwrap(), &target)).To(BeTrue())
+			Expect(errors.Is(err, errCause)).To(BeTrue())
+		})
+	})
+
+	Context("when classifying errors", func() {
+		It("should classify errors as fatal by default", func() {
+			for _, err := range []Message{
+				NewError(errCause),
+				NewErrorWithMessageID(errCause, taskutils.RandomMessageID()),
+			} {
+				Expect(err.(Error).Kind()).To(Equal(ErrorKindFatal))
+				Expect(err.(Error).IsTransient()).To(BeFalse())
+			}
+		})
+
+		It("should classify transient errors as transient", func() {
+			err := NewTransientError(errCause).(Error)
+			Expect(err.Kind()).To(Equal(ErrorKindTransient))
+			Expect(err.IsTransient()).To(BeTrue())
+			Expect(errors.Is(err, errCause)).To(BeTrue())
+		})
+
+		It("should preserve the classification when wrapping", func() {
+			msgid := taskutils.RandomMessageID()
+			transient := NewTransientError(errCause).(Error)
+
+			err := NewError(transient).(Error)
+			Expect(err.IsTransient()).To(BeTrue())
+
+			err = NewErrorWithMessageID(transient, msgid).(Error)
+			Expect(err.IsTransient()).To(BeTrue())
+			id, ok := err.MessageID()
+			Expect(ok).To(BeTrue())
+			Expect(id).To(Equal(msgid))
+
+			err = NewTransientError(NewErrorWithMessageID(errCause, msgid).(Error)).(Error)
+			Expect(err.IsTransient()).To(BeTrue())
+			id, ok = err.MessageID()
+			Expect(ok).To(BeTrue())
+			Expect(id).To(Equal(msgid))
+		})
+	})
+})
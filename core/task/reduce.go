@@ -1,7 +1,9 @@
 package task
 
 // A Reducer consumes input Messages, uses them to modify its state, and
-// produces output Messages in response.
+// produces output Messages in response. A Task only ever calls its Reducer from
+// one goroutine, so the Reducer does not need to synchronise access to its
+// state unless that state is shared outside of the Task.
 type Reducer interface {
 
 	// Reduce a new state from the current state and the Message. An output
@@ -1,6 +1,8 @@
 package task
 
 import (
+	"time"
+
 	"github.com/republicprotocol/co-go"
 )
 
@@ -15,13 +17,23 @@ type Task interface {
 	// signal to the Task that it should terminate, however the Task can also
 	// terminate without the done channel being closed. Running a Task will
 	// drive all input/output with its parent and children. This blocks the
-	// current goroutine.
+	// current goroutine. The Reducer of the Task is only ever called from a
+	// single goroutine started by Run, so it does not need to synchronise
+	// access to its own state.
 	Run(done <-chan struct{})
 
-	// Send a Message to the Task. Sending a Message to a Task should only be
-	// done by the parent Task. This will never block.
+	// Send a Message to the Task. Messages sent to a Task are delivered by its
+	// parent Task, so the Task must have a running parent for them to be
+	// reduced. It is safe to call Send from multiple goroutines. This will
+	// never block.
 	Send(Message)
 
+	// SendTimeout sends a Message to the Task. If the Task cannot accept the
+	// Message, it blocks until the Task can accept it, or until the timeout
+	// has elapsed. Returns true if the Message was sent, otherwise it returns
+	// false. It is safe to call SendTimeout from multiple goroutines.
+	SendTimeout(Message, time.Duration) bool
+
 	// IO returns the IO object used by the Task to handle input/output with its
 	// parent.
 	IO() IO
@@ -64,6 +76,10 @@ func (task *task) Send(message Message) {
 	task.io.WriteIn(message)
 }
 
+func (task *task) SendTimeout(message Message, timeout time.Duration) bool {
+	return task.io.WriteInTimeout(message, timeout)
+}
+
 func (task *task) IO() IO {
 	return task.io
 }
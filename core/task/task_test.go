@@ -1 +1,130 @@
 package task_test
+
+import (
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/republicprotocol/tau/core/task"
+)
+
+var _ = Describe("Tasks", func() {
+
+	// newCounter returns a parent Task that counts the Messages output by its
+	// child. The child echoes all Messages that are sent to it. The received
+	// channel is closed once n Messages have been counted.
+	newCounter := func(n int) (parent, child Task, received <-chan struct{}) {
+		r := make(chan struct{})
+		count := 0
+
+		child = New(NewIO(n), ReduceFunc(func(message Message) Message {
+			return message
+		}))
+		parent = New(NewIO(n), ReduceFunc(func(message Message) Message {
+			count++
+			if count == n {
+				close(r)
+			}
+			return nil
+		}), child)
+		return parent, child, r
+	}
+
+	Context("when sending messages from multiple goroutines", func() {
+		It("should reduce all of the messages", func() {
+			numGoroutines := 16
+			numMessages := 64
+
+			parent, child, received := newCounter(numGoroutines * numMessages)
+
+			done := make(chan struct{})
+			defer close(done)
+			go parent.Run(done)
+
+			// Give the parent time to block waiting for input, so that it must
+			// be woken up by the sends
+			time.Sleep(10 * time.Millisecond)
+
+			wg := new(sync.WaitGroup)
+			for i := 0; i < numGoroutines; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					for j := 0; j < numMessages; j++ {
+						child.Send(RandomMessage{})
+					}
+				}()
+			}
+			wg.Wait()
+
+			Eventually(received, 10*time.Second).Should(BeClosed())
+			Expect(child.IO().Stats().Dropped).To(Equal(uint64(0)))
+		})
+	})
+
+	Context("when sending messages with a timeout", func() {
+		It("should fail when there is no parent to deliver the messages", func() {
+			t := New(NewIO(1), ReduceFunc(func(message Message) Message {
+				return nil
+			}))
+			Expect(t.SendTimeout(RandomMessage{}, 10*time.Millisecond)).To(BeTrue())
+			Expect(t.SendTimeout(RandomMessage{}, 10*time.Millisecond)).To(BeFalse())
+			Expect(t.IO().Stats().Dropped).To(Equal(uint64(1)))
+		})
+
+		It("should succeed when a slow consumer is given enough time", func() {
+			numMessages := 32
+
+			received := make(chan struct{})
+			count := 0
+			child := New(NewIO(1), ReduceFunc(func(message Message) Message {
+				time.Sleep(time.Millisecond)
+				count++
+				if count == numMessages {
+					close(received)
+				}
+				return nil
+			}))
+			parent := New(NewIO(1), ReduceFunc(func(message Message) Message {
+				return nil
+			}), child)
+
+			done := make(chan struct{})
+			defer close(done)
+			go parent.Run(done)
+
+			for i := 0; i < numMessages; i++ {
+				Expect(child.SendTimeout(RandomMessage{}, time.Second)).To(BeTrue())
+			}
+			Eventually(received, 10*time.Second).Should(BeClosed())
+
+			stats := child.IO().Stats()
+			Expect(stats.Blocked).To(BeNumerically(">", 0))
+			Expect(stats.Dropped).To(Equal(uint64(0)))
+		})
+	})
+
+	Context("when sending a message batch to multiple tasks", func() {
+		It("should not modify the message batch", func() {
+			numTasks := 8
+			batch := NewMessageBatch([]Message{RandomMessage{}, nil, RandomMessage{}, nil})
+
+			done := make(chan struct{})
+			defer close(done)
+
+			reduced := make(chan Message, 4*numTasks)
+			for i := 0; i < numTasks; i++ {
+				t := New(NewIO(1), ReduceFunc(func(message Message) Message {
+					reduced <- message
+					return nil
+				}))
+				go t.Run(done)
+				t.IO().InputWriter() <- batch
+			}
+
+			Eventually(func() int { return len(reduced) }).Should(Equal(2 * numTasks))
+			Expect(batch).To(Equal(NewMessageBatch([]Message{RandomMessage{}, nil, RandomMessage{}, nil})))
+		})
+	})
+})
@@ -5,8 +5,12 @@ import "github.com/republicprotocol/tau/core/task"
 type (
 	Error = task.Error
 
+	ErrorKind = task.ErrorKind
+
 	IO = task.IO
 
+	IOStats = task.IOStats
+
 	Message = task.Message
 
 	MessageBatch = task.MessageBatch
@@ -26,14 +30,26 @@ type (
 	Tick = task.Tick
 )
 
+const (
+	ErrorKindFatal = task.ErrorKindFatal
+
+	ErrorKindTransient = task.ErrorKindTransient
+)
+
 var (
 	New = task.New
 
 	NewError = task.NewError
 
+	NewErrorWithMessageID = task.NewErrorWithMessageID
+
+	NewTransientError = task.NewTransientError
+
 	NewIO = task.NewIO
 
 	NewMessageBatch = task.NewMessageBatch
 
 	NewTick = task.NewTick
+
+	ParseMessageID = task.ParseMessageID
 )